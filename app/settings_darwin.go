@@ -0,0 +1,42 @@
+package app
+
+import (
+	"os/exec"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// startSystemThemeWatch polls `defaults read -g AppleInterfaceStyle` for changes and invokes cb
+// with the new variant whenever the user flips between light and dark mode in System Settings.
+// The distributed notification AppleInterfaceThemeChangedNotification has no stable Go binding
+// without cgo, so we fall back to a light poll instead.
+func startSystemThemeWatch(cb func(fyne.ThemeVariant)) {
+	go pollAndNotify(time.Second, currentAppleVariant, cb)
+}
+
+func currentAppleVariant() fyne.ThemeVariant {
+	out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+	if err != nil || !strings.Contains(strings.ToLower(string(out)), "dark") {
+		return theme.VariantLight
+	}
+	return theme.VariantDark
+}
+
+// currentOSReduceMotion reports NSWorkspace.accessibilityDisplayShouldReduceMotion, read via the
+// equivalent `com.apple.universalaccess reduceMotion` default.
+func currentOSReduceMotion() bool {
+	out, err := exec.Command("defaults", "read", "com.apple.universalaccess", "reduceMotion").Output()
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(out)) == "1"
+}
+
+// startAccessibilityWatch polls the reduceMotion default for changes and invokes cb with the new
+// value whenever the user toggles "Reduce motion" in Accessibility settings.
+func startAccessibilityWatch(cb func(bool)) {
+	go pollAndNotify(time.Second, currentOSReduceMotion, cb)
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// startSystemThemeWatch polls the GNOME `gtk-theme` / `color-scheme` gsettings keys for changes
+// and invokes cb with the new variant. Only gsettings is read: the freedesktop.org
+// org.freedesktop.appearance "color-scheme" D-Bus signal is not subscribed to, so a desktop whose
+// appearance setting is not stored in these GNOME keys is not detected.
+func startSystemThemeWatch(cb func(fyne.ThemeVariant)) {
+	go pollAndNotify(time.Second, currentLinuxVariant, cb)
+}
+
+func currentLinuxVariant() fyne.ThemeVariant {
+	out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output()
+	if err == nil && strings.Contains(string(out), "dark") {
+		return theme.VariantDark
+	}
+
+	out, err = exec.Command("gsettings", "get", "org.gnome.desktop.interface", "gtk-theme").Output()
+	if err == nil && strings.Contains(strings.ToLower(string(out)), "dark") {
+		return theme.VariantDark
+	}
+
+	return theme.VariantLight
+}
+
+// currentOSReduceMotion checks GNOME's `enable-animations` key, falling back to KDE's
+// kdeglobals [KDE] AnimationDurationFactor when gsettings has nothing to say.
+func currentOSReduceMotion() bool {
+	out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "enable-animations").Output()
+	if err == nil {
+		return strings.TrimSpace(string(out)) == "false"
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return false
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".config", "kdeglobals"))
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(data), "AnimationDurationFactor=0")
+}
+
+// startAccessibilityWatch polls the desktop's animation setting for changes and invokes cb with
+// the new reduced-motion value.
+func startAccessibilityWatch(cb func(bool)) {
+	go pollAndNotify(time.Second, currentOSReduceMotion, cb)
+}
@@ -0,0 +1,18 @@
+//go:build !darwin && !windows && !linux
+
+package app
+
+import "fyne.io/fyne/v2"
+
+// startSystemThemeWatch is a no-op on platforms with no supported hook for OS appearance changes.
+func startSystemThemeWatch(_ func(fyne.ThemeVariant)) {
+}
+
+// currentOSReduceMotion is a no-op on platforms with no supported accessibility hook.
+func currentOSReduceMotion() bool {
+	return false
+}
+
+// startAccessibilityWatch is a no-op on platforms with no supported accessibility hook.
+func startAccessibilityWatch(_ func(bool)) {
+}
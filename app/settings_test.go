@@ -0,0 +1,90 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/internal/app"
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestSettings_RegisterTheme(t *testing.T) {
+	s := &settings{}
+
+	assert.Empty(t, s.AvailableThemes())
+
+	s.RegisterTheme("nord", theme.DefaultTheme())
+	s.RegisterTheme("solarized", theme.DefaultTheme())
+
+	assert.ElementsMatch(t, []string{"nord", "solarized"}, s.AvailableThemes())
+
+	th, ok := s.themeNamed("nord")
+	assert.True(t, ok)
+	assert.Equal(t, theme.DefaultTheme(), th)
+
+	_, ok = s.themeNamed("missing")
+	assert.False(t, ok)
+}
+
+func TestSettings_ThemeFileChanged_RespectsExplicitTheme(t *testing.T) {
+	custom := theme.DefaultTheme()
+	s := &settings{theme: custom, themeSpecified: true, variant: theme.VariantDark}
+
+	// themeFileChanged must not call loadSystemTheme (and so never touch disk) once an app has
+	// called SetTheme - it would otherwise clobber an explicitly chosen theme on every edit of
+	// the user's theme.json.
+	s.themeFileChanged()
+
+	assert.Same(t, custom, s.Theme())
+	assert.Equal(t, theme.VariantDark, s.ThemeVariant())
+}
+
+func TestSettings_StoragePath(t *testing.T) {
+	s := &settings{}
+	assert.Equal(t, s.schema.StoragePath(), s.storagePath())
+
+	// set directly rather than via SetAppID, which also triggers a real load/save - this test
+	// only cares about the path computation
+	s.appID = "com.example.myapp"
+	assert.Equal(t, filepath.Join(app.RootConfigDir(), "com.example.myapp", "settings.json"), s.storagePath())
+}
+
+func TestFollowsSystemTheme(t *testing.T) {
+	assert.True(t, followsSystemTheme("system"))
+	assert.False(t, followsSystemTheme(""))
+	assert.False(t, followsSystemTheme("light"))
+	assert.False(t, followsSystemTheme("dark"))
+	assert.False(t, followsSystemTheme("my-custom-theme"))
+}
+
+func TestSettings_ShowAnimations(t *testing.T) {
+	defer func(old bool) { noAnimations = old }(noAnimations)
+
+	tests := map[string]struct {
+		buildTag           bool
+		disableAnimations  bool
+		animationsExplicit bool
+		osReduceMotion     bool
+		want               bool
+	}{
+		"default shows animations":                        {want: true},
+		"explicit enable wins over build tag":             {buildTag: true, animationsExplicit: true, want: true},
+		"OS reduced motion wins with no explicit pref":    {osReduceMotion: true, want: false},
+		"explicit disable wins over OS":                   {disableAnimations: true, animationsExplicit: true, osReduceMotion: false, want: false},
+		"explicit enable wins over OS":                    {animationsExplicit: true, osReduceMotion: true, want: true},
+		"unset-but-disabled schema still counts explicit": {disableAnimations: true, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			noAnimations = tt.buildTag
+			s := &settings{osReduceMotion: tt.osReduceMotion}
+			s.schema.DisableAnimations = tt.disableAnimations
+			s.schema.AnimationsExplicit = tt.animationsExplicit
+
+			assert.Equal(t, tt.want, s.ShowAnimations())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows/registry"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// startSystemThemeWatch polls the AppsUseLightTheme registry value for changes and invokes cb
+// with the new variant whenever the user flips the Windows "Choose your mode" setting.
+func startSystemThemeWatch(cb func(fyne.ThemeVariant)) {
+	go pollAndNotify(time.Second, currentWindowsVariant, cb)
+}
+
+func currentWindowsVariant() fyne.ThemeVariant {
+	k, err := registry.OpenKey(registry.CURRENT_USER,
+		`Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, registry.QUERY_VALUE)
+	if err != nil {
+		return theme.VariantLight
+	}
+	defer k.Close()
+
+	light, _, err := k.GetIntegerValue("AppsUseLightTheme")
+	if err != nil || light != 0 {
+		return theme.VariantLight
+	}
+	return theme.VariantDark
+}
+
+// currentOSReduceMotion reports reduced motion when the registry value MinAnimate under
+// HKCU\Control Panel\Desktop\WindowMetrics is "0", i.e. when minimize/restore window animations
+// are turned off. SystemParametersInfo is not called, so the separate client area animation
+// setting (SPI_GETCLIENTAREAANIMATION) is not consulted.
+func currentOSReduceMotion() bool {
+	k, err := registry.OpenKey(registry.CURRENT_USER,
+		`Control Panel\Desktop\WindowMetrics`, registry.QUERY_VALUE)
+	if err != nil {
+		return false
+	}
+	defer k.Close()
+
+	animate, _, err := k.GetStringValue("MinAnimate")
+	if err != nil {
+		return false
+	}
+	return animate == "0"
+}
+
+// startAccessibilityWatch polls the animation setting for changes and invokes cb with the new
+// reduced-motion value whenever the user toggles window animations.
+func startAccessibilityWatch(cb func(bool)) {
+	go pollAndNotify(time.Second, currentOSReduceMotion, cb)
+}